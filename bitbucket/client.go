@@ -0,0 +1,217 @@
+package bitbucket
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+// Clients bundles the API clients handed to every resource/data source via
+// the provider's meta interface.
+type Clients struct {
+	httpClient *BitbucketClient
+}
+
+// BitbucketClient is a thin wrapper around http.Client that authenticates
+// requests against the Bitbucket API, retries transient failures, and
+// surfaces non-2xx responses (other than a 404 on GET or DELETE) as a typed
+// Error.
+type BitbucketClient struct {
+	HttpClient *http.Client
+	Username   string
+	Password   string
+	BaseURL    string
+
+	// MaxRetries, RetryWaitMin and RetryWaitMax control the exponential
+	// backoff applied to requests that fail with a 429 or 5xx response.
+	MaxRetries   int
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+}
+
+// Error is returned by BitbucketClient for any non-2xx response except a 404
+// on GET or DELETE, which is returned with a nil error. It carries enough
+// detail for callers to produce a meaningful Terraform error message.
+type Error struct {
+	Endpoint   string
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("bitbucket: %s (endpoint %q, status %d)", e.Message, e.Endpoint, e.StatusCode)
+}
+
+type errorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (c *BitbucketClient) Get(endpoint string) (*http.Response, error) {
+	return c.doWithRetry(http.MethodGet, endpoint, nil)
+}
+
+func (c *BitbucketClient) Put(endpoint string, body io.Reader) (*http.Response, error) {
+	return c.doWithRetry(http.MethodPut, endpoint, body)
+}
+
+func (c *BitbucketClient) Delete(endpoint string) (*http.Response, error) {
+	return c.doWithRetry(http.MethodDelete, endpoint, nil)
+}
+
+func (c *BitbucketClient) PostNonJson(endpoint string, body io.Reader) (*http.Response, error) {
+	return c.doWithRetry(http.MethodPost, endpoint, body)
+}
+
+// doWithRetry performs the request, retrying on 429 and 5xx responses with
+// exponential backoff up to MaxRetries, and returns a typed *Error for any
+// response that is still non-2xx once retries are exhausted, except a 404 on
+// GET or DELETE, which is returned with a nil error.
+func (c *BitbucketClient) doWithRetry(method, endpoint string, body io.Reader) (*http.Response, error) {
+	// 0 is a legitimate "no retries" value (e.g. a user setting
+	// max_retries = 0 on the provider block), so only a negative value
+	// falls back to the default; a zero-valued MaxRetries means no retries.
+	maxRetries := c.MaxRetries
+	if maxRetries < 0 {
+		maxRetries = defaultMaxRetries
+	}
+
+	// Buffer the body once so every retry attempt can replay it from the
+	// start; a io.Reader passed straight to http.NewRequest is drained by
+	// the first attempt, leaving retries to send an empty body.
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		bodyBytes, err = ioutil.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		resp, err = c.do(method, endpoint, bodyReader(bodyBytes, body != nil))
+		if err != nil {
+			return nil, err
+		}
+
+		if !c.shouldRetry(resp.StatusCode) || attempt == maxRetries {
+			break
+		}
+
+		wait := c.backoff(attempt)
+		log.Printf("[DEBUG] Retrying %s %s after status %d (attempt %d/%d, waiting %s)",
+			method, endpoint, resp.StatusCode, attempt+1, maxRetries, wait)
+
+		// Drain and close the body of the attempt we're discarding so its
+		// connection can be reused/released instead of leaking.
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+
+		time.Sleep(wait)
+	}
+
+	// 404 is left for callers to handle explicitly on both GET (e.g.
+	// removing a resource from state on a Read) and DELETE (the target is
+	// already gone, which is success for an idempotent delete). For
+	// writes that create/modify something (PUT/POST), a 404 means the
+	// write itself failed and must surface as an error, or callers like
+	// addGroupMember would silently no-op against a group/user that
+	// doesn't exist.
+	notFoundIsOk := method == http.MethodGet || method == http.MethodDelete
+	if (resp.StatusCode < 200 || resp.StatusCode >= 300) && !(notFoundIsOk && resp.StatusCode == http.StatusNotFound) {
+		return resp, c.decodeError(endpoint, resp)
+	}
+
+	return resp, nil
+}
+
+// bodyReader returns a fresh reader over bodyBytes for each retry attempt,
+// or nil if the original request had no body.
+func bodyReader(bodyBytes []byte, hasBody bool) io.Reader {
+	if !hasBody {
+		return nil
+	}
+
+	return bytes.NewReader(bodyBytes)
+}
+
+func (c *BitbucketClient) shouldRetry(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+func (c *BitbucketClient) backoff(attempt int) time.Duration {
+	waitMin := c.RetryWaitMin
+	if waitMin <= 0 {
+		waitMin = defaultRetryWaitMin
+	}
+
+	waitMax := c.RetryWaitMax
+	if waitMax <= 0 {
+		waitMax = defaultRetryWaitMax
+	}
+
+	wait := waitMin * time.Duration(math.Pow(2, float64(attempt)))
+	if wait > waitMax {
+		wait = waitMax
+	}
+
+	return wait
+}
+
+func (c *BitbucketClient) decodeError(endpoint string, resp *http.Response) error {
+	apiErr := &Error{
+		Endpoint:   endpoint,
+		StatusCode: resp.StatusCode,
+		Message:    resp.Status,
+	}
+
+	if resp.Body == nil {
+		return apiErr
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil || len(body) == 0 {
+		return apiErr
+	}
+
+	var parsed errorResponse
+	if err := json.Unmarshal(body, &parsed); err == nil && parsed.Error.Message != "" {
+		apiErr.Message = parsed.Error.Message
+	}
+
+	return apiErr
+}
+
+func (c *BitbucketClient) do(method, endpoint string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, fmt.Sprintf("%s/%s", c.BaseURL, endpoint), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetBasicAuth(c.Username, c.Password)
+	switch method {
+	case http.MethodPut:
+		req.Header.Set("Content-Type", "application/json")
+	case http.MethodPost:
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	return c.HttpClient.Do(req)
+}
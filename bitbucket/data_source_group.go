@@ -0,0 +1,106 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"auto_add": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"permission": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGroupRead(d *schema.ResourceData, m interface{}) error {
+	workspace := d.Get("workspace").(string)
+
+	groups, err := listGroups(m, workspace)
+	if err != nil {
+		return err
+	}
+
+	slug := d.Get("slug").(string)
+	name := d.Get("name").(string)
+
+	if slug == "" && name == "" {
+		return fmt.Errorf("one of slug or name must be set")
+	}
+
+	var match *UserGroup
+	for i := range groups {
+		if (slug != "" && groups[i].Slug == slug) || (name != "" && groups[i].Name == name) {
+			match = &groups[i]
+			break
+		}
+	}
+
+	if match == nil {
+		return fmt.Errorf("no group found in workspace %q matching slug %q / name %q", workspace, slug, name)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, match.Slug))
+	d.Set("slug", match.Slug)
+	d.Set("name", match.Name)
+	d.Set("auto_add", match.AutoAdd)
+	d.Set("permission", match.Permission)
+
+	return nil
+}
+
+// listGroups fetches every group defined in a workspace via the 1.0 groups
+// endpoint, which is shared by resourceGroup and the group data sources.
+func listGroups(m interface{}, workspace string) ([]UserGroup, error) {
+	client := m.(Clients).httpClient
+
+	groupsReq, err := client.Get(fmt.Sprintf("1.0/groups/%s", workspace))
+	if err != nil {
+		return nil, err
+	}
+
+	if groupsReq.Body == nil {
+		return nil, fmt.Errorf("error reading Groups (%s): empty response", workspace)
+	}
+
+	body, err := ioutil.ReadAll(groupsReq.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Groups Response JSON: %v", string(body))
+
+	var groups []UserGroup
+	if err := json.Unmarshal(body, &groups); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
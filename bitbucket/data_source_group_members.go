@@ -0,0 +1,53 @@
+package bitbucket
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGroupMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGroupMembersRead(d *schema.ResourceData, m interface{}) error {
+	workspace := d.Get("workspace").(string)
+	groupSlug := d.Get("group_slug").(string)
+
+	grp, err := getGroupWithMembers(m, workspace, groupSlug)
+	if err != nil {
+		return err
+	}
+
+	if grp == nil {
+		return fmt.Errorf("group (%s/%s) not found", workspace, groupSlug)
+	}
+
+	members := make([]interface{}, len(grp.Members))
+	for i, member := range grp.Members {
+		members[i] = member.Username
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, groupSlug))
+	d.Set("members", members)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package bitbucket
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auto_add": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"permission": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupsRead(d *schema.ResourceData, m interface{}) error {
+	workspace := d.Get("workspace").(string)
+
+	groups, err := listGroups(m, workspace)
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex, err = regexp.Compile(v.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	result := make([]map[string]interface{}, 0, len(groups))
+	for _, group := range groups {
+		if nameRegex != nil && !nameRegex.MatchString(group.Name) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"slug":       group.Slug,
+			"name":       group.Name,
+			"auto_add":   group.AutoAdd,
+			"permission": group.Permission,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/groups", workspace))
+	d.Set("groups", result)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package bitbucket
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     defaultMaxRetries,
+				Description: "Maximum number of retries on a 429 or 5xx response from the Bitbucket API.",
+			},
+			"retry_wait_min": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     int(defaultRetryWaitMin / time.Second),
+				Description: "Minimum time to wait, in seconds, before retrying a failed request.",
+			},
+			"retry_wait_max": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     int(defaultRetryWaitMax / time.Second),
+				Description: "Maximum time to wait, in seconds, before retrying a failed request.",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"bitbucket_group":            resourceGroup(),
+			"bitbucket_group_membership": resourceGroupMembership(),
+			"bitbucket_group_permission": resourceGroupPermission(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"bitbucket_group":         dataSourceGroup(),
+			"bitbucket_groups":        dataSourceGroups(),
+			"bitbucket_group_members": dataSourceGroupMembers(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	return Clients{
+		httpClient: &BitbucketClient{
+			HttpClient:   http.DefaultClient,
+			Username:     d.Get("username").(string),
+			Password:     d.Get("password").(string),
+			BaseURL:      "https://api.bitbucket.org",
+			MaxRetries:   d.Get("max_retries").(int),
+			RetryWaitMin: time.Duration(d.Get("retry_wait_min").(int)) * time.Second,
+			RetryWaitMax: time.Duration(d.Get("retry_wait_max").(int)) * time.Second,
+		},
+	}, nil
+}
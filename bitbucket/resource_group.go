@@ -14,10 +14,11 @@ import (
 )
 
 type UserGroup struct {
-	Name       string `json:"name,omitempty"`
-	Slug       string `json:"slug,omitempty"`
-	AutoAdd    bool   `json:"auto_add,omitempty"`
-	Permission string `json:"permission,omitempty"`
+	Name       string        `json:"name,omitempty"`
+	Slug       string        `json:"slug,omitempty"`
+	AutoAdd    bool          `json:"auto_add,omitempty"`
+	Permission string        `json:"permission,omitempty"`
+	Members    []GroupMember `json:"members,omitempty"`
 }
 
 func resourceGroup() *schema.Resource {
@@ -97,7 +98,10 @@ func resourceGroupsRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	groupsReq, _ := client.Get(fmt.Sprintf("1.0/groups/%s/%s", workspace, slug))
+	groupsReq, err := client.Get(fmt.Sprintf("1.0/groups/%s/%s", workspace, slug))
+	if err != nil {
+		return err
+	}
 
 	if groupsReq.StatusCode == http.StatusNotFound {
 		log.Printf("[WARN] Group (%s) not found, removing from state", d.Id())
@@ -0,0 +1,245 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GroupMember identifies a Bitbucket user by the username the 1.0 groups
+// API keys members on. This is the same identifier the members/{username}
+// PUT/DELETE endpoints expect, so it is the only one this resource tracks.
+type GroupMember struct {
+	Username string `json:"username,omitempty"`
+}
+
+func resourceGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGroupMembershipCreate,
+		Read:   resourceGroupMembershipRead,
+		Update: resourceGroupMembershipUpdate,
+		Delete: resourceGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Members are Bitbucket usernames, matching the members/{username}
+			// path segment of the 1.0 API this resource calls.
+			"members": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipCreate(d *schema.ResourceData, m interface{}) error {
+	workspace := d.Get("workspace").(string)
+	groupSlug := d.Get("group_slug").(string)
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, groupSlug))
+
+	if err := updateGroupMembership(d, m); err != nil {
+		return err
+	}
+
+	return resourceGroupMembershipRead(d, m)
+}
+
+func resourceGroupMembershipRead(d *schema.ResourceData, m interface{}) error {
+	workspace, groupSlug, err := groupId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	grp, err := getGroupWithMembers(m, workspace, groupSlug)
+	if err != nil {
+		return err
+	}
+
+	if grp == nil {
+		log.Printf("[WARN] Group (%s) not found, removing group membership from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("group_slug", groupSlug)
+
+	actual := make([]interface{}, len(grp.Members))
+	for i, member := range grp.Members {
+		actual[i] = member.Username
+	}
+
+	if d.Get("exclusive").(bool) {
+		d.Set("members", actual)
+		return nil
+	}
+
+	actualSet := schema.NewSet(schema.HashString, actual)
+	configured := d.Get("members").(*schema.Set)
+
+	var present []interface{}
+	for _, member := range configured.List() {
+		if actualSet.Contains(member) {
+			present = append(present, member)
+		}
+	}
+	d.Set("members", present)
+
+	return nil
+}
+
+func resourceGroupMembershipUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := updateGroupMembership(d, m); err != nil {
+		return err
+	}
+
+	return resourceGroupMembershipRead(d, m)
+}
+
+func resourceGroupMembershipDelete(d *schema.ResourceData, m interface{}) error {
+	workspace := d.Get("workspace").(string)
+	groupSlug := d.Get("group_slug").(string)
+
+	members := d.Get("members").(*schema.Set)
+	for _, member := range members.List() {
+		if err := removeGroupMember(m, workspace, groupSlug, member.(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateGroupMembership reconciles the members configured on the resource
+// with the members actually present on the Bitbucket group. In exclusive
+// mode any member present on the group but not in config is removed,
+// guaranteeing the resource is authoritative over group membership. In
+// additive mode only the members added by this resource are ever touched.
+func updateGroupMembership(d *schema.ResourceData, m interface{}) error {
+	workspace := d.Get("workspace").(string)
+	groupSlug := d.Get("group_slug").(string)
+	exclusive := d.Get("exclusive").(bool)
+
+	var toAdd, toRemove *schema.Set
+
+	if d.IsNewResource() {
+		toAdd = d.Get("members").(*schema.Set)
+		toRemove = schema.NewSet(schema.HashString, nil)
+	} else {
+		old, new := d.GetChange("members")
+		oldSet := old.(*schema.Set)
+		newSet := new.(*schema.Set)
+
+		toAdd = newSet.Difference(oldSet)
+		toRemove = oldSet.Difference(newSet)
+	}
+
+	for _, member := range toAdd.List() {
+		if err := addGroupMember(m, workspace, groupSlug, member.(string)); err != nil {
+			return err
+		}
+	}
+
+	for _, member := range toRemove.List() {
+		if err := removeGroupMember(m, workspace, groupSlug, member.(string)); err != nil {
+			return err
+		}
+	}
+
+	if !exclusive {
+		return nil
+	}
+
+	grp, err := getGroupWithMembers(m, workspace, groupSlug)
+	if err != nil {
+		return err
+	}
+
+	if grp == nil {
+		return fmt.Errorf("group (%s/%s) not found while reconciling membership", workspace, groupSlug)
+	}
+
+	desired := d.Get("members").(*schema.Set)
+	for _, member := range grp.Members {
+		if !desired.Contains(member.Username) {
+			if err := removeGroupMember(m, workspace, groupSlug, member.Username); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func addGroupMember(m interface{}, workspace, groupSlug, username string) error {
+	client := m.(Clients).httpClient
+
+	log.Printf("[DEBUG] Adding %s to group %s/%s", username, workspace, groupSlug)
+
+	_, err := client.Put(fmt.Sprintf("1.0/groups/%s/%s/members/%s/", workspace, groupSlug, username), nil)
+
+	return err
+}
+
+func removeGroupMember(m interface{}, workspace, groupSlug, username string) error {
+	client := m.(Clients).httpClient
+
+	log.Printf("[DEBUG] Removing %s from group %s/%s", username, workspace, groupSlug)
+
+	_, err := client.Delete(fmt.Sprintf("1.0/groups/%s/%s/members/%s/", workspace, groupSlug, username))
+
+	return err
+}
+
+// getGroupWithMembers fetches a single group, including its member list, or
+// returns a nil group if it no longer exists.
+func getGroupWithMembers(m interface{}, workspace, groupSlug string) (*UserGroup, error) {
+	client := m.(Clients).httpClient
+
+	groupReq, err := client.Get(fmt.Sprintf("1.0/groups/%s/%s", workspace, groupSlug))
+	if err != nil {
+		return nil, err
+	}
+
+	if groupReq.StatusCode == 404 {
+		return nil, nil
+	}
+
+	if groupReq.Body == nil {
+		return nil, fmt.Errorf("error reading Group (%s/%s): empty response", workspace, groupSlug)
+	}
+
+	body, err := ioutil.ReadAll(groupReq.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var grp *UserGroup
+	if err := json.Unmarshal(body, &grp); err != nil {
+		return nil, err
+	}
+
+	return grp, nil
+}
@@ -0,0 +1,235 @@
+package bitbucket
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGroupPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGroupPermissionCreate,
+		Read:   resourceGroupPermissionRead,
+		Update: resourceGroupPermissionUpdate,
+		Delete: resourceGroupPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository_slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"repository_slug", "project_key"},
+			},
+			"project_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"repository_slug", "project_key"},
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"read", "write", "admin"}, false),
+			},
+		},
+	}
+}
+
+func resourceGroupPermissionCreate(d *schema.ResourceData, m interface{}) error {
+	path, scope, err := groupPermissionPath(d)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", d.Get("workspace").(string), scope, d.Get("group_slug").(string)))
+
+	if err := putGroupPermission(m, path, d.Get("permission").(string)); err != nil {
+		return err
+	}
+
+	return resourceGroupPermissionRead(d, m)
+}
+
+func resourceGroupPermissionRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(Clients).httpClient
+
+	workspace, scope, groupSlug, err := groupPermissionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	path, err := pathForScope(workspace, scope, groupSlug)
+	if err != nil {
+		return err
+	}
+
+	permReq, err := client.Get(path)
+	if err != nil {
+		return err
+	}
+
+	if permReq.StatusCode == http.StatusNotFound {
+		log.Printf("[WARN] Group permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if permReq.Body == nil {
+		return fmt.Errorf("error reading Group Permission (%s): empty response", d.Id())
+	}
+
+	body, err := ioutil.ReadAll(permReq.Body)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Group Permission Response JSON: %v", string(body))
+
+	var perm struct {
+		Permission string `json:"permission"`
+	}
+
+	if err := json.Unmarshal(body, &perm); err != nil {
+		return err
+	}
+
+	d.Set("workspace", workspace)
+	d.Set("group_slug", groupSlug)
+	d.Set("permission", perm.Permission)
+
+	scopeType, scopeValue, err := splitScope(scope)
+	if err != nil {
+		return err
+	}
+
+	switch scopeType {
+	case "repository":
+		d.Set("repository_slug", scopeValue)
+	case "project":
+		d.Set("project_key", scopeValue)
+	}
+
+	return nil
+}
+
+func resourceGroupPermissionUpdate(d *schema.ResourceData, m interface{}) error {
+	path, _, err := groupPermissionPath(d)
+	if err != nil {
+		return err
+	}
+
+	if err := putGroupPermission(m, path, d.Get("permission").(string)); err != nil {
+		return err
+	}
+
+	return resourceGroupPermissionRead(d, m)
+}
+
+func resourceGroupPermissionDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(Clients).httpClient
+
+	path, _, err := groupPermissionPath(d)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Delete(path)
+
+	return err
+}
+
+func putGroupPermission(m interface{}, path, permission string) error {
+	client := m.(Clients).httpClient
+
+	log.Printf("[DEBUG] Group Permission Request: %s -> %s", path, permission)
+
+	bytedata, err := json.Marshal(struct {
+		Permission string `json:"permission"`
+	}{Permission: permission})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(path, bytes.NewBuffer(bytedata))
+
+	return err
+}
+
+// groupPermissionPath resolves the 2.0 permissions-config endpoint and the
+// opaque "scope" segment (repository:SLUG or project:KEY) stored as part of
+// the resource ID, based on which of repository_slug/project_key is set.
+func groupPermissionPath(d *schema.ResourceData) (path, scope string, err error) {
+	workspace := d.Get("workspace").(string)
+	groupSlug := d.Get("group_slug").(string)
+	repoSlug := d.Get("repository_slug").(string)
+	projectKey := d.Get("project_key").(string)
+
+	switch {
+	case repoSlug != "":
+		scope = fmt.Sprintf("repository:%s", repoSlug)
+	case projectKey != "":
+		scope = fmt.Sprintf("project:%s", projectKey)
+	default:
+		return "", "", fmt.Errorf("one of repository_slug or project_key must be set")
+	}
+
+	path, err = pathForScope(workspace, scope, groupSlug)
+
+	return path, scope, err
+}
+
+func pathForScope(workspace, scope, groupSlug string) (string, error) {
+	scopeType, scopeValue, err := splitScope(scope)
+	if err != nil {
+		return "", err
+	}
+
+	switch scopeType {
+	case "repository":
+		return fmt.Sprintf("2.0/repositories/%s/%s/permissions-config/groups/%s", workspace, scopeValue, groupSlug), nil
+	case "project":
+		return fmt.Sprintf("2.0/workspaces/%s/projects/%s/permissions-config/groups/%s", workspace, scopeValue, groupSlug), nil
+	default:
+		return "", fmt.Errorf("unexpected scope %q", scope)
+	}
+}
+
+func splitScope(scope string) (scopeType, scopeValue string, err error) {
+	parts := strings.SplitN(scope, ":", 2)
+
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("unexpected format of scope (%q), expected TYPE:VALUE", scope)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func groupPermissionId(id string) (workspace, scope, groupSlug string, err error) {
+	parts := strings.SplitN(id, "/", 3)
+
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/SCOPE/GROUP-SLUG-ID", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}